@@ -0,0 +1,50 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"strconv"
+	"syscall"
+
+	"github.com/gocondor/core/env"
+)
+
+// Warn if the max number of open files is too low for serving requests under load,
+// if App_RAISE_OPEN_FILES_LIMIT is set to true it tries to raise the soft limit first
+func checkOpenFilesLimit() {
+	minOpenFiles, err := strconv.ParseUint(env.GetVarOtherwiseDefault("App_MIN_OPEN_FILES", "4096"), 10, 64)
+	if err != nil {
+		log.Fatal("error parsing env var App_MIN_OPEN_FILES")
+	}
+	raiseLimit, _ := strconv.ParseBool(env.GetVarOtherwiseDefault("App_RAISE_OPEN_FILES_LIMIT", "false"))
+	var limit syscall.Rlimit
+	err = syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit)
+	if err != nil {
+		log.Printf("warning: could not read the open files limit: %v\n", err)
+		return
+	}
+	if limit.Cur >= minOpenFiles {
+		return
+	}
+	if raiseLimit {
+		newLimit := limit
+		newLimit.Cur = minOpenFiles
+		if newLimit.Cur > limit.Max {
+			newLimit.Cur = limit.Max
+		}
+		err = syscall.Setrlimit(syscall.RLIMIT_NOFILE, &newLimit)
+		if err == nil {
+			log.Printf("raised the open files limit from %v to %v\n", limit.Cur, newLimit.Cur)
+			limit = newLimit
+		} else {
+			log.Printf("warning: could not raise the open files limit: %v\n", err)
+		}
+		if limit.Cur >= minOpenFiles {
+			return
+		}
+	}
+	log.Printf("warning: the open files limit is %v which is below the recommended %v, the app might fail to accept connections under load (check `ulimit -n`)\n", limit.Cur, minOpenFiles)
+}
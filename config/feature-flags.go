@@ -0,0 +1,19 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package config
+
+import "github.com/gocondor/gocondor/utils"
+
+// Retrieve the source of the feature flags, use the base path to build the files paths
+func GetFeatureFlagsProvider(basePath string) utils.FeatureFlagsProvider {
+	//#############################################
+	//# Main configuration for feature flags  #####
+	//#############################################
+
+	// By default the flags are read from the env vars, e.g. the flag `new-auth` is read from FEATURE_NEW_AUTH
+	// to read the flags from a json file instead use:
+	// return &utils.FileFeatureFlags{FilePath: path.Join(basePath, "storage/feature-flags.json")}
+	return utils.EnvFeatureFlags{}
+}
@@ -4,7 +4,14 @@
 
 package config
 
-import "github.com/gocondor/core"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gocondor/core"
+	"github.com/gocondor/core/env"
+	"gorm.io/gorm/schema"
+)
 
 // Retrieve the main config for the GORM
 func GetGormConfig() core.GormConfig {
@@ -18,3 +25,49 @@ func GetGormConfig() core.GormConfig {
 		EnableGorm: false,
 	}
 }
+
+// Retrieve the naming strategy GORM uses to build table and column names
+// models defining a TableName() method keep the name it returns, the strategy isn't applied to it
+func GetGormNamingStrategy() schema.NamingStrategy {
+	//##############################################
+	//# Naming strategy configuration for GORM #####
+	//##############################################
+
+	singularTable, _ := strconv.ParseBool(env.GetVarOtherwiseDefault("DB_SINGULAR_TABLE_NAMES", "false"))
+	return schema.NamingStrategy{
+		// A prefix added to all table names, e.g. "app_"
+		TablePrefix: env.GetVar("DB_TABLE_PREFIX"),
+		// Set to true to use singular table names, e.g. "user" instead of "users"
+		SingularTable: singularTable,
+		// Replace parts of the names before they are converted to snake case
+		// NameReplacer: strings.NewReplacer("CID", "Cid"),
+	}
+}
+
+// Retrieve the data source names of the read replicas
+func GetGormReplicas() []string {
+	//#######################################
+	//# Read replicas configuration   #####
+	//#######################################
+
+	// The replicas are set in the env var DB_REPLICAS as a comma separated list,
+	// for sqlite it's the list of the database files paths relative to the base path
+	var replicas []string
+	for _, dsn := range strings.Split(env.GetVar("DB_REPLICAS"), ",") {
+		dsn = strings.TrimSpace(dsn)
+		if dsn != "" {
+			replicas = append(replicas, dsn)
+		}
+	}
+	return replicas
+}
+
+// Retrieve the version of the uuids generated for the models embedding models.UUIDModel
+func GetGormUUIDVersion() int {
+	// Set to 4 for random uuids, or to 7 for time ordered uuids which are better for the index locality
+	version, err := strconv.Atoi(env.GetVarOtherwiseDefault("DB_UUID_VERSION", "4"))
+	if err != nil {
+		return 4
+	}
+	return version
+}
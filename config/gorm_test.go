@@ -0,0 +1,44 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/gocondor/gocondor/config"
+	"github.com/gocondor/gocondor/models"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestGetGormNamingStrategy(t *testing.T) {
+	cases := []struct {
+		prefix   string
+		singular string
+		expected string
+	}{
+		{prefix: "", singular: "false", expected: "users"},
+		{prefix: "app_", singular: "false", expected: "app_users"},
+		{prefix: "", singular: "true", expected: "user"},
+		{prefix: "app_", singular: "true", expected: "app_user"},
+	}
+	for _, tc := range cases {
+		t.Setenv("DB_TABLE_PREFIX", tc.prefix)
+		t.Setenv("DB_SINGULAR_TABLE_NAMES", tc.singular)
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+		if err != nil {
+			t.Fatalf("failed opening the database: %v", err)
+		}
+		db.Config.NamingStrategy = config.GetGormNamingStrategy()
+		stmt := &gorm.Statement{DB: db}
+		err = stmt.Parse(&models.User{})
+		if err != nil {
+			t.Fatalf("failed parsing the model: %v", err)
+		}
+		if stmt.Schema.Table != tc.expected {
+			t.Errorf("failed testing gorm naming strategy, expected %q got %q", tc.expected, stmt.Schema.Table)
+		}
+	}
+}
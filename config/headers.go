@@ -0,0 +1,20 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package config
+
+// Retrieve the headers to be set on every response
+func GetDefaultHeadersConfig() map[string]string {
+	//##################################################
+	//# Main configuration for the default headers #####
+	//##################################################
+
+	return map[string]string{
+		// Add the headers you want to send with every response here...
+		// handlers can't override them, setting the same key in a handler sends both values
+		// the framework itself doesn't send an `X-Powered-By` header, so there is nothing to remove
+		// "X-App-Version":   "1.0.0",
+		// "X-Frame-Options": "DENY",
+	}
+}
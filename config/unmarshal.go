@@ -0,0 +1,120 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fill the given struct pointer with the env vars named in the fields tags, e.g.
+//
+//	type AppConfig struct {
+//		DBHost  string        `env:"DB_HOST" default:"localhost"`
+//		Secret  string        `env:"JWT_SECRET" required:"true"`
+//		Timeout time.Duration `env:"APP_TIMEOUT" default:"30s"`
+//		Hosts   []string      `env:"App_HTTPS_HOSTS"`
+//	}
+//
+// nested structs are filled as well, all the missing required and invalid vars are reported together
+func Unmarshal(cfg interface{}) error {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return errors.New("config must be a pointer to a struct")
+	}
+	return errors.Join(unmarshalStruct(v.Elem())...)
+}
+
+func unmarshalStruct(v reflect.Value) []error {
+	var errs []error
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		varName, hasTag := field.Tag.Lookup("env")
+		if !hasTag {
+			if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Time{}) {
+				errs = append(errs, unmarshalStruct(v.Field(i))...)
+			}
+			continue
+		}
+		val, isSet := os.LookupEnv(varName)
+		if !isSet || val == "" {
+			val, isSet = field.Tag.Lookup("default")
+		}
+		if !isSet || val == "" {
+			if required, _ := strconv.ParseBool(field.Tag.Get("required")); required {
+				errs = append(errs, fmt.Errorf("env var %v is required", varName))
+			}
+			continue
+		}
+		err := setField(v.Field(i), val)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("env var %v has an invalid value: %v", varName, err))
+		}
+	}
+	return errs
+}
+
+func setField(field reflect.Value, val string) error {
+	if field.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(val)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(val, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(val, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(val, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %v", field.Type())
+		}
+		var items []string
+		for _, item := range strings.Split(val, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %v", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package events
+
+import (
+	"github.com/gocondor/core"
+)
+
+// Run the given function in the background after the response is sent to the client,
+// useful for side effects that the client doesn't need to wait for (analytics, cache warming, ...)
+// keep in mind the function must not use the request's response since it's already sent
+func AfterResponse(c *core.Context, fn func()) error {
+	return c.GetEventsManager().Fire(&core.Event{
+		Name: AFTER_RESPONSE,
+		Payload: map[string]interface{}{
+			"fn": fn,
+		},
+	})
+}
@@ -4,3 +4,4 @@ package events
 const USER_REGISTERED = "user-registered"
 const USER_PASSWORD_RESET_REQUESTED = "user-password-reset-requested"
 const PASSWORD_CHANGED = "password-changed"
+const AFTER_RESPONSE = "after-response"
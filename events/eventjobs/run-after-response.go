@@ -0,0 +1,15 @@
+package eventjobs
+
+import (
+	"github.com/gocondor/core"
+	"github.com/gocondor/gocondor/utils"
+)
+
+var RunAfterResponse core.EventJob = func(event *core.Event, c *core.Context) {
+	fn, ok := event.Payload["fn"].(func())
+	if !ok {
+		c.GetLogger().Error("[RunAfterResponse job] invalid function")
+		return
+	}
+	utils.SafeGo(fn)
+}
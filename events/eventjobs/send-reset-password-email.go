@@ -6,10 +6,11 @@ import (
 
 	"github.com/gocondor/core"
 	"github.com/gocondor/gocondor/models"
+	"github.com/gocondor/gocondor/utils"
 )
 
 var SendResetPasswordEmail core.EventJob = func(event *core.Event, c *core.Context) {
-	go func() {
+	utils.SafeGo(func() {
 		mailer := c.GetMailer()
 		logger := c.GetLogger()
 
@@ -34,5 +35,5 @@ var SendResetPasswordEmail core.EventJob = func(event *core.Event, c *core.Conte
 		body := fmt.Sprintf("Hi %v, <br>Click the link below to reset your password <br><a href=\"%v\">Reset Password</a>. <br>Thanks.", user.Name, resetPasswordLink)
 		mailer.SetHTMLBody(body)
 		mailer.Send()
-	}()
+	})
 }
@@ -5,10 +5,11 @@ import (
 
 	"github.com/gocondor/core"
 	"github.com/gocondor/gocondor/models"
+	"github.com/gocondor/gocondor/utils"
 )
 
 var SendWelcomeEmail core.EventJob = func(event *core.Event, c *core.Context) {
-	go func() {
+	utils.SafeGo(func() {
 		mailer := c.GetMailer()
 		logger := c.GetLogger()
 
@@ -27,5 +28,5 @@ var SendWelcomeEmail core.EventJob = func(event *core.Event, c *core.Context) {
 		body := fmt.Sprintf("Hi %v, \nWelcome to GoCondor \nYour account have been created successfully. \nThanks.", user.Name)
 		mailer.SetPlainTextBody(body)
 		mailer.Send()
-	}()
+	})
 }
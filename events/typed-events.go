@@ -0,0 +1,45 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package events
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gocondor/core"
+)
+
+// Register a job for the typed event T, the event name is derived from the type
+// e.g. events.On(func(e UserRegistered, c *core.Context) { ... })
+func On[T any](job func(event T, c *core.Context)) {
+	core.ResolveEventsManager().Register(TypedEventName[T](), func(event *core.Event, c *core.Context) {
+		payload, ok := event.Payload["event"].(T)
+		if !ok {
+			c.GetLogger().Error(fmt.Sprintf("invalid payload for the event %v", event.Name))
+			return
+		}
+		job(payload, c)
+	})
+}
+
+// Fire the typed event, the jobs registered for its type using On are executed
+// e.g. events.Emit(UserRegistered{User: user})
+func Emit[T any](event T) error {
+	return core.ResolveEventsManager().Fire(&core.Event{
+		Name: TypedEventName[T](),
+		Payload: map[string]interface{}{
+			"event": event,
+		},
+	})
+}
+
+// Retrieve the name under which the typed event T is registered
+func TypedEventName[T any]() string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Name() == "" {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
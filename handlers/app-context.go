@@ -0,0 +1,24 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package handlers
+
+import (
+	"github.com/gocondor/core"
+)
+
+// The context passed to the handlers wrapped with WithAppContext,
+// add your app specific helper methods to it (e.g. c.CurrentOrganization())
+type AppContext struct {
+	*core.Context
+}
+
+// Adapt a handler receiving the app context to be registered as a route handler
+// the app context is a small wrapper allocated once per request
+// e.g. router.Get("/orgs", handlers.WithAppContext(handlers.ListOrganizations))
+func WithAppContext(handler func(c *AppContext) *core.Response) core.Handler {
+	return func(c *core.Context) *core.Response {
+		return handler(&AppContext{Context: c})
+	}
+}
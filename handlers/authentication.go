@@ -9,7 +9,6 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gocondor/core"
@@ -361,9 +360,8 @@ func SetNewPassword(c *core.Context) *core.Response {
 }
 
 func Signout(c *core.Context) *core.Response {
-	tokenRaw := c.GetHeader("Authorization")
-	token := strings.TrimSpace(strings.Replace(tokenRaw, "Bearer", "", 1))
-	if token == "" {
+	token, ok := utils.GetBearerToken(c)
+	if !ok {
 		return c.Response.SetStatusCode(http.StatusUnauthorized).Json(c.MapToJson(map[string]interface{}{
 			"message": "unauthorized",
 		}))
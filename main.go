@@ -8,11 +8,13 @@ import (
 	"log"
 	"os"
 	"path"
+	"strconv"
 
 	"github.com/gocondor/core"
 	"github.com/gocondor/core/env"
 	"github.com/gocondor/core/logger"
 	"github.com/gocondor/gocondor/config"
+	"github.com/gocondor/gocondor/utils"
 	"github.com/joho/godotenv"
 	"github.com/julienschmidt/httprouter"
 )
@@ -34,6 +36,7 @@ func main() {
 		}
 		env.SetEnvVars(envVars)
 	}
+	checkOpenFilesLimit()
 	// Handle the logs
 	app.SetLogsDriver(&logger.LogFileDriver{
 		FilePath: path.Join(basePath, "logs/app.log"),
@@ -41,12 +44,28 @@ func main() {
 	app.SetRequestConfig(config.GetRequestConfig())
 	app.SetGormConfig(config.GetGormConfig())
 	app.SetCacheConfig(config.GetCacheConfig())
+	utils.SetFeatureFlagsProvider(config.GetFeatureFlagsProvider(basePath))
+	waitForDependencies()
 	app.Bootstrap()
 	registerGlobalMiddlewares()
+	registerMiddlewaresGroups()
 	registerRoutes()
+	validateRoutes()
 	registerEvents()
 	if config.GetGormConfig().EnableGorm == true {
+		db := core.ResolveGorm()
+		db.Config.NamingStrategy = config.GetGormNamingStrategy()
+		err = utils.UseDBReplicas(db, env.GetVar("DB_DRIVER"), config.GetGormReplicas(), basePath)
+		if err != nil {
+			log.Fatal(err)
+		}
 		RunAutoMigrations()
 	}
-	app.Run(httprouter.New())
+	router := httprouter.New()
+	// Redirect the requests with a different case or extra slashes (e.g. `/Users//42`) to the matching route
+	caseInsensitiveRoutes, _ := strconv.ParseBool(env.GetVarOtherwiseDefault("App_ROUTES_CASE_INSENSITIVE", "true"))
+	router.RedirectFixedPath = caseInsensitiveRoutes
+	registerProxies(router)
+	printBootSummary()
+	app.Run(router)
 }
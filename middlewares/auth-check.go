@@ -2,8 +2,6 @@ package middlewares
 
 import (
 	"errors"
-	"net/http"
-	"strings"
 
 	"github.com/gocondor/core"
 	"github.com/gocondor/gocondor/models"
@@ -12,19 +10,14 @@ import (
 )
 
 var AuthCheck core.Middleware = func(c *core.Context) {
-	tokenRaw := c.GetHeader("Authorization")
-	token := strings.TrimSpace(strings.Replace(tokenRaw, "Bearer", "", 1))
-	if token == "" {
-		c.Response.SetStatusCode(http.StatusUnauthorized).Json(c.MapToJson(map[string]interface{}{
-			"message": "unauthorized",
-		})).ForceSendResponse()
+	token, ok := utils.GetBearerToken(c)
+	if !ok {
+		utils.AbortUnauthorized(c, "unauthorized")
 		return
 	}
 	payload, err := c.GetJWT().DecodeToken(token)
 	if err != nil {
-		c.Response.SetStatusCode(http.StatusUnauthorized).Json(c.MapToJson(map[string]interface{}{
-			"message": "unauthorized",
-		})).ForceSendResponse()
+		utils.AbortUnauthorized(c, "unauthorized")
 		return
 	}
 	userAgent := c.GetUserAgent()
@@ -33,16 +26,12 @@ var AuthCheck core.Middleware = func(c *core.Context) {
 	cachedToken, err := c.GetCache().Get(hashedCacheKey)
 	if err != nil {
 		// user signed out
-		c.Response.SetStatusCode(http.StatusUnauthorized).Json(c.MapToJson(map[string]interface{}{
-			"message": "unauthorized",
-		})).ForceSendResponse()
+		utils.AbortUnauthorized(c, "unauthorized")
 		return
 	}
 	if cachedToken != token {
 		// using old token replaced with new one after recent signin
-		c.Response.SetStatusCode(http.StatusUnauthorized).Json(c.MapToJson(map[string]interface{}{
-			"message": "unauthorized",
-		})).ForceSendResponse()
+		utils.AbortUnauthorized(c, "unauthorized")
 		return
 	}
 
@@ -51,17 +40,13 @@ var AuthCheck core.Middleware = func(c *core.Context) {
 	if res.Error != nil && !errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		// error with the database
 		c.GetLogger().Error(res.Error.Error())
-		c.Response.SetStatusCode(http.StatusInternalServerError).Json(c.MapToJson(map[string]interface{}{
-			"message": "internal error",
-		})).ForceSendResponse()
+		utils.AbortInternalServerError(c, "internal error")
 		return
 	}
 
 	if res.Error != nil && errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		// user record is not found (deleted)
-		c.Response.SetStatusCode(http.StatusUnauthorized).Json(c.MapToJson(map[string]interface{}{
-			"message": "unauthorized",
-		})).ForceSendResponse()
+		utils.AbortUnauthorized(c, "unauthorized")
 		return
 	}
 
@@ -0,0 +1,22 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package middlewares
+
+import (
+	"github.com/gocondor/core"
+	"github.com/gocondor/gocondor/config"
+)
+
+// Set the headers defined in config/headers.go on every response
+// the defaults can't be overridden per handler, core adds the response headers without
+// replacing existing ones, so a handler setting the same key sends both values,
+// don't set a header in a handler if it's one of the defaults.
+// core's 404, 405 and panic responses don't go through the middlewares and don't carry these headers
+var DefaultHeaders core.Middleware = func(c *core.Context) {
+	for key, val := range config.GetDefaultHeadersConfig() {
+		c.Response.SetHeader(key, val)
+	}
+	c.Next()
+}
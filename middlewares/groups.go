@@ -0,0 +1,39 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package middlewares
+
+import (
+	"fmt"
+
+	"github.com/gocondor/core"
+)
+
+var groups = map[string][]core.Middleware{}
+
+// Define a named group of middlewares, the middlewares are executed in the given order
+// defining the same group name twice panics to avoid silently replacing a group
+func Group(name string, mws ...core.Middleware) {
+	_, exists := groups[name]
+	if exists {
+		panic(fmt.Sprintf("middlewares group %v is already defined", name))
+	}
+	groups[name] = mws
+}
+
+// Retrieve the middlewares of the given groups to be attached to a route,
+// the groups are combined in the given order, a middleware that belongs to more than
+// one of the groups is executed once for each of them
+// e.g. router.Get("/dashboard", handlers.WelcomeToDashboard, middlewares.Groups("web", "auth")...)
+func Groups(names ...string) []core.Middleware {
+	var mws []core.Middleware
+	for _, name := range names {
+		group, exists := groups[name]
+		if !exists {
+			panic(fmt.Sprintf("middlewares group %v is not defined", name))
+		}
+		mws = append(mws, group...)
+	}
+	return mws
+}
@@ -0,0 +1,21 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package middlewares
+
+import (
+	"github.com/gocondor/core"
+)
+
+// Force the content type of the route's response regardless of what the handler sets
+// e.g. router.Get("/users", handlers.ListUsers, middlewares.Produces(core.CONTENT_TYPE_JSON))
+func Produces(contentType string) core.Middleware {
+	return func(c *core.Context) {
+		// set before the handler in case it stops the response from being changed
+		c.Response.SetContentType(contentType)
+		c.Next()
+		// and after it in case it overrides the content type
+		c.Response.SetContentType(contentType)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package middlewares
+
+import (
+	"github.com/gocondor/core"
+	"github.com/gocondor/gocondor/utils"
+)
+
+// Execute the given middleware only when the given feature flag is enabled,
+// the flag is checked on every request so the middleware can be toggled at runtime
+// e.g. core.UseMiddleware(middlewares.UseWhen("new-auth", middlewares.ExampleMiddleware))
+func UseWhen(flagName string, mw core.Middleware) core.Middleware {
+	return func(c *core.Context) {
+		if utils.IsFeatureEnabled(flagName) {
+			mw(c)
+			return
+		}
+		c.Next()
+	}
+}
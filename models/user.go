@@ -12,8 +12,3 @@ type User struct {
 	Email    string
 	Password string
 }
-
-// Override the table name
-func (User) TableName() string {
-	return "users"
-}
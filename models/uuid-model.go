@@ -0,0 +1,41 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package models
+
+import (
+	"time"
+
+	"github.com/gocondor/gocondor/config"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// A base model with a uuid primary key, embed it instead of gorm.Model to get the uuid generated on create
+// the uuid version is set in config/gorm.go
+type UUIDModel struct {
+	ID        string `gorm:"type:varchar(36);primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// Generate the uuid before creating the record if it's not set
+func (m *UUIDModel) BeforeCreate(tx *gorm.DB) error {
+	if m.ID != "" {
+		return nil
+	}
+	var id uuid.UUID
+	var err error
+	if config.GetGormUUIDVersion() == 7 {
+		id, err = uuid.NewV7()
+	} else {
+		id, err = uuid.NewRandom()
+	}
+	if err != nil {
+		return err
+	}
+	m.ID = id.String()
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package models
+
+import (
+	"errors"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Returned when saving a versioned record that was changed by someone else since it was read,
+// respond with 409 conflict when it's returned
+var ErrVersionConflict = errors.New("the record was changed since it was read")
+
+// Returned when saving a versioned record that has no primary key, it must be created first
+var ErrVersionedMissingPrimaryKey = errors.New("the versioned record has no primary key")
+
+// Embed it in a model to use optimistic locking, the version is incremented on every save
+type VersionedModel struct {
+	Version uint `gorm:"not null;default:1"`
+}
+
+// Implemented by the models embedding VersionedModel
+type Versioned interface {
+	GetVersion() uint
+	SetVersion(version uint)
+}
+
+func (m *VersionedModel) GetVersion() uint {
+	return m.Version
+}
+
+func (m *VersionedModel) SetVersion(version uint) {
+	m.Version = version
+}
+
+// Save the changes of a versioned record, ErrVersionConflict is returned if
+// its version in the database is not the one it was read with
+// e.g. err := models.SaveVersioned(c.GetGorm(), &product)
+func SaveVersioned(db *gorm.DB, model Versioned) error {
+	// without a primary key the version would be the only condition and every row with it would be updated
+	stmt := &gorm.Statement{DB: db}
+	err := stmt.Parse(model)
+	if err != nil {
+		return err
+	}
+	pk := stmt.Schema.PrioritizedPrimaryField
+	if pk == nil {
+		return ErrVersionedMissingPrimaryKey
+	}
+	_, isZero := pk.ValueOf(db.Statement.Context, reflect.Indirect(reflect.ValueOf(model)))
+	if isZero {
+		return ErrVersionedMissingPrimaryKey
+	}
+	versionField := stmt.Schema.LookUpField("Version")
+	if versionField == nil {
+		return errors.New("the versioned record has no Version field")
+	}
+	current := model.GetVersion()
+	model.SetVersion(current + 1)
+	version := clause.Column{Table: clause.CurrentTable, Name: versionField.DBName}
+	res := db.Model(model).Where(clause.Eq{Column: version, Value: current}).Select("*").Omit("CreatedAt").Updates(model)
+	if res.Error != nil {
+		model.SetVersion(current)
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		model.SetVersion(current)
+		return ErrVersionConflict
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gocondor/core"
+	"github.com/gocondor/core/env"
+	"github.com/gocondor/gocondor/config"
+)
+
+// Print a summary of the app configuration at boot, set App_PRINT_BOOT_SUMMARY to false to disable it
+// keep in mind that no credentials should be added to the summary
+func printBootSummary() {
+	printSummary, _ := strconv.ParseBool(env.GetVarOtherwiseDefault("App_PRINT_BOOT_SUMMARY", "true"))
+	if !printSummary {
+		return
+	}
+	// the addresses core binds in app.Run, it listens on all interfaces
+	port := env.GetVar("App_HTTP_PORT")
+	if port == "" {
+		port = "80"
+	}
+	address := fmt.Sprintf(":%v (http)", port)
+	useHttps, _ := strconv.ParseBool(env.GetVar("App_USE_HTTPS"))
+	useLetsEncrypt, _ := strconv.ParseBool(env.GetVar("App_USE_LETSENCRYPT"))
+	if useHttps && useLetsEncrypt {
+		address = fmt.Sprintf(":443 (https, let's encrypt, hosts: %v)", env.GetVar("App_HTTPS_HOSTS"))
+	} else if useHttps {
+		address = ":443 (https)"
+	}
+	database := "disabled"
+	if config.GetGormConfig().EnableGorm {
+		driver := env.GetVar("DB_DRIVER")
+		switch driver {
+		case "mysql":
+			database = fmt.Sprintf("mysql (host: %v:%v, db: %v)", env.GetVar("MYSQL_HOST"), env.GetVar("MYSQL_PORT"), env.GetVar("MYSQL_DB_NAME"))
+		case "postgres":
+			database = fmt.Sprintf("postgres (host: %v:%v, db: %v)", env.GetVar("POSTGRES_HOST"), env.GetVar("POSTGRES_PORT"), env.GetVar("POSTGRES_DB_NAME"))
+		case "sqlite":
+			database = fmt.Sprintf("sqlite (path: %v)", env.GetVar("SQLITE_DB_PATH"))
+		default:
+			database = driver
+		}
+		if replicas := len(config.GetGormReplicas()); replicas > 0 {
+			database = fmt.Sprintf("%v + %v read replicas", database, replicas)
+		}
+	}
+	cache := "disabled"
+	if config.GetCacheConfig().EnableCache {
+		cache = fmt.Sprintf("%v (host: %v:%v)", env.GetVarOtherwiseDefault("CACHE_DRIVER", "redis"), env.GetVar("REDIS_HOST"), env.GetVar("REDIS_PORT"))
+	}
+	lines := []string{
+		fmt.Sprintf("app:                %v", env.GetVar("APP_NAME")),
+		fmt.Sprintf("environment:        %v", env.GetVarOtherwiseDefault("APP_ENV", "local")),
+		fmt.Sprintf("debug mode:         %v", env.GetVar("APP_DEBUG_MODE")),
+		fmt.Sprintf("listening on:       %v", address),
+		fmt.Sprintf("database:           %v", database),
+		fmt.Sprintf("cache:              %v", cache),
+		fmt.Sprintf("routes:             %v", len(core.ResolveRouter().GetRoutes())),
+		fmt.Sprintf("global middlewares: %v", len(core.ResolveMiddlewares().GetMiddlewares())),
+	}
+	fmt.Printf("Boot summary\n  %v\n", strings.Join(lines, "\n  "))
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gocondor/core/env"
+	"github.com/gocondor/gocondor/config"
+	"github.com/gocondor/gocondor/utils"
+)
+
+// Wait for the services the app depends on to be reachable before serving requests,
+// it's enabled by setting App_WAIT_FOR_DEPENDENCIES_TIMEOUT (e.g. 60s), the app exits if the timeout is hit
+func waitForDependencies() {
+	timeoutStr := env.GetVar("App_WAIT_FOR_DEPENDENCIES_TIMEOUT")
+	if timeoutStr == "" {
+		return
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil {
+		log.Fatal("error parsing env var App_WAIT_FOR_DEPENDENCIES_TIMEOUT")
+	}
+	var deps []utils.Dependency
+	//########################################
+	//#      dependencies registration   #####
+	//########################################
+
+	if config.GetGormConfig().EnableGorm {
+		switch env.GetVar("DB_DRIVER") {
+		case "mysql":
+			deps = append(deps, utils.TCPDependency("mysql", fmt.Sprintf("%v:%v", env.GetVar("MYSQL_HOST"), env.GetVar("MYSQL_PORT"))))
+		case "postgres":
+			deps = append(deps, utils.TCPDependency("postgres", fmt.Sprintf("%v:%v", env.GetVar("POSTGRES_HOST"), env.GetVar("POSTGRES_PORT"))))
+		}
+	}
+	if config.GetCacheConfig().EnableCache {
+		deps = append(deps, utils.TCPDependency("redis", fmt.Sprintf("%v:%v", env.GetVar("REDIS_HOST"), env.GetVar("REDIS_PORT"))))
+	}
+	// Register your dependencies here ...
+	// deps = append(deps, utils.HTTPDependency("payments", "http://payments:8080/healthz"))
+
+	err = utils.WaitForDependencies(deps, timeout)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -22,4 +22,8 @@ func registerEvents() {
 	eventsManager.Register(events.USER_REGISTERED, eventjobs.TestEvent)
 	eventsManager.Register(events.USER_PASSWORD_RESET_REQUESTED, eventjobs.SendResetPasswordEmail)
 	eventsManager.Register(events.PASSWORD_CHANGED, eventjobs.SendPasswordChangedEmail)
+	eventsManager.Register(events.AFTER_RESPONSE, eventjobs.RunAfterResponse)
+
+	// register your typed events here ...
+	// events.On(func(e events.UserRegisteredEvent, c *core.Context) { ... })
 }
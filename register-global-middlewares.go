@@ -4,6 +4,11 @@
 
 package main
 
+import (
+	"github.com/gocondor/core"
+	"github.com/gocondor/gocondor/middlewares"
+)
+
 // Register middlewares globally
 func registerGlobalMiddlewares() {
 	//########################################
@@ -11,5 +16,8 @@ func registerGlobalMiddlewares() {
 	//########################################
 
 	// Register global middlewares here ...
+	core.UseMiddleware(middlewares.DefaultHeaders)
 	// core.UseMiddleware(middlewares.AnotherExampleMiddleware)
+	// Register a middleware that only runs when the feature flag is enabled
+	// core.UseMiddleware(middlewares.UseWhen("example-flag", middlewares.ExampleMiddleware))
 }
@@ -0,0 +1,53 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gocondor/core"
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestGlobalMiddlewaresRunOnRequests(t *testing.T) {
+	app := core.New()
+	var calls []string
+	core.UseMiddleware(func(c *core.Context) {
+		calls = append(calls, "middleware")
+		c.Response.SetHeader("X-Global-Middleware", "ran")
+		c.Next()
+	})
+	routes := []core.Route{
+		{
+			Method: core.GET,
+			Path:   "/",
+			Handler: func(c *core.Context) *core.Response {
+				calls = append(calls, "handler")
+				return c.Response.Text("ok")
+			},
+		},
+	}
+	router := app.RegisterRoutes(routes, httprouter.New())
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	b, err := io.ReadAll(w.Body)
+	if err != nil {
+		t.Errorf("failed testing global middlewares")
+	}
+	if string(b) != "ok" {
+		t.Errorf("failed testing global middlewares, expected the handler response got %q", string(b))
+	}
+	if w.Header().Get("X-Global-Middleware") != "ran" {
+		t.Errorf("failed testing global middlewares, the middleware header is missing")
+	}
+	if len(calls) != 2 || calls[0] != "middleware" || calls[1] != "handler" {
+		t.Errorf("failed testing global middlewares, expected the middleware to run before the handler got %v", calls)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+// Register named groups of middlewares to be attached to routes
+func registerMiddlewaresGroups() {
+	//########################################
+	//# Middlewares groups registration  #####
+	//########################################
+
+	// Register your middlewares groups here ...
+	// middlewares.Group("auth", middlewares.AuthCheck)
+}
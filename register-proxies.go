@@ -0,0 +1,25 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/julienschmidt/httprouter"
+)
+
+// Register reverse proxies to upstream services
+// keep in mind that proxied requests are forwarded as is, they don't go through the app's middlewares
+func registerProxies(router *httprouter.Router) {
+	//########################################
+	//# Reverse proxies registration     #####
+	//########################################
+
+	// Register your reverse proxies here ...
+	// err := utils.MountReverseProxy(router, "/api/*path", "http://backend:8080", utils.ReverseProxyOptions{
+	// 	Timeout: time.Second * 30,
+	// })
+	// if err != nil {
+	// 	log.Fatal(err)
+	// }
+}
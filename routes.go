@@ -18,6 +18,8 @@ func registerRoutes() {
 
 	// Define your routes here...
 	router.Get("/", handlers.WelcomeHome)
+	// Catch-all routes match the rest of the path, use utils.GetCatchAllPathParam(c, "filepath") to read it
+	// router.Get("/files/*filepath", handlers.ServeFile)
 	// Uncomment the lines below to enable authentication
 	// router.Post("/signup", handlers.Signup)
 	// router.Post("/signin", handlers.Signin)
@@ -25,4 +27,6 @@ func registerRoutes() {
 	// router.Post("/reset-password", handlers.ResetPasswordRequest)
 	// router.Post("/reset-password/code/:code", handlers.SetNewPassword)
 	// router.Get("/dashboard", handlers.WelcomeToDashboard, middlewares.AuthCheck)
+	// Attach a middlewares group defined in register-middlewares-groups.go
+	// router.Get("/dashboard", handlers.WelcomeToDashboard, middlewares.Groups("auth")...)
 }
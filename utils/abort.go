@@ -0,0 +1,45 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"net/http"
+
+	"github.com/gocondor/core"
+)
+
+// Stop the request and respond with 400 bad request
+func AbortBadRequest(c *core.Context, msg string) *core.Response {
+	return abort(c, http.StatusBadRequest, msg)
+}
+
+// Stop the request and respond with 401 unauthorized
+func AbortUnauthorized(c *core.Context, msg string) *core.Response {
+	return abort(c, http.StatusUnauthorized, msg)
+}
+
+// Stop the request and respond with 403 forbidden
+func AbortForbidden(c *core.Context, msg string) *core.Response {
+	return abort(c, http.StatusForbidden, msg)
+}
+
+// Stop the request and respond with 404 not found
+func AbortNotFound(c *core.Context, msg string) *core.Response {
+	return abort(c, http.StatusNotFound, msg)
+}
+
+// Stop the request and respond with 500 internal server error
+func AbortInternalServerError(c *core.Context, msg string) *core.Response {
+	return abort(c, http.StatusInternalServerError, msg)
+}
+
+// set the json error response and prevent the next middlewares and handlers from changing it,
+// keep in mind that a middleware must still return without calling c.Next() to stop the chain
+func abort(c *core.Context, status int, msg string) *core.Response {
+	c.Response.SetStatusCode(status).Json(c.MapToJson(map[string]string{
+		"message": msg,
+	})).ForceSendResponse()
+	return c.Response
+}
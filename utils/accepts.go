@@ -0,0 +1,68 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gocondor/core"
+)
+
+// Check if the client accepts the given content type (e.g. `application/json`) according to the `Accept` header,
+// wildcards like `application/*` and `*/*` are supported, a type with the quality `q=0` is not accepted,
+// a missing `Accept` header means any type is accepted
+func Accepts(c *core.Context, contentType string) bool {
+	return acceptQuality(c.GetHeader("Accept"), contentType) > 0
+}
+
+// Check if the client prefers a json response over html
+func WantsJSON(c *core.Context) bool {
+	accept := c.GetHeader("Accept")
+	jsonQuality := acceptQuality(accept, "application/json")
+	return jsonQuality > 0 && jsonQuality >= acceptQuality(accept, "text/html")
+}
+
+// the quality of the most specific media range in the header matching the content type
+func acceptQuality(accept string, contentType string) float64 {
+	if strings.TrimSpace(accept) == "" {
+		return 1
+	}
+	contentType = strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	mainType, _, _ := strings.Cut(contentType, "/")
+	quality := 0.0
+	specificity := -1
+	for _, mediaRange := range strings.Split(accept, ",") {
+		params := strings.Split(mediaRange, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+		var s int
+		switch mediaType {
+		case contentType:
+			s = 2
+		case mainType + "/*":
+			s = 1
+		case "*/*", "*":
+			s = 0
+		default:
+			continue
+		}
+		if s <= specificity {
+			continue
+		}
+		q := 1.0
+		for _, param := range params[1:] {
+			key, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if strings.EqualFold(key, "q") {
+				parsed, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+				if err == nil {
+					q = parsed
+				}
+			}
+		}
+		specificity = s
+		quality = q
+	}
+	return quality
+}
@@ -0,0 +1,22 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/gocondor/core"
+)
+
+// Retrieve the bearer token from the header `Authorization: Bearer <token>`
+// the scheme is case-insensitive, the second return value is false if the header
+// is missing or not in the bearer form
+func GetBearerToken(c *core.Context) (string, bool) {
+	parts := strings.Fields(c.GetHeader("Authorization"))
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return "", false
+	}
+	return parts[1], true
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gocondor/core"
+	"github.com/gocondor/core/env"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrMissingCursorSecret = errors.New("App_CURSOR_SECRET and JWT_SECRET are empty, can't sign the cursors")
+
+// The position in a list to continue paginating from
+type Cursor struct {
+	// The order column the cursor was created for
+	Column string `json:"column"`
+	// The json encoded value of the order column of the last record in the previous page,
+	// it's decoded into the type of the order field when paginating
+	After json.RawMessage `json:"after"`
+}
+
+// Encode the cursor into a signed opaque string to be returned to the client
+func EncodeCursor(cursor Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	signature, err := signCursor(encodedPayload)
+	if err != nil {
+		return "", err
+	}
+	return encodedPayload + "." + signature, nil
+}
+
+// Decode a cursor returned by EncodeCursor, ErrInvalidCursor is returned if it's malformed or tampered with
+func DecodeCursor(encoded string) (Cursor, error) {
+	var cursor Cursor
+	encodedPayload, signature, found := strings.Cut(encoded, ".")
+	if !found {
+		return cursor, ErrInvalidCursor
+	}
+	expectedSignature, err := signCursor(encodedPayload)
+	if err != nil {
+		return cursor, err
+	}
+	if !hmac.Equal([]byte(signature), []byte(expectedSignature)) {
+		return cursor, ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	err = json.Unmarshal(payload, &cursor)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+// Retrieve the cursor from the query param `cursor`, nil is returned when it's not set
+func GetCursor(c *core.Context) (*Cursor, error) {
+	encoded := c.CastToString(c.GetRequestParam("cursor"))
+	if encoded == "" {
+		return nil, nil
+	}
+	cursor, err := DecodeCursor(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+// Retrieve a page of records ordered by the given column into dest (a pointer to a slice of models)
+// starting after the cursor (nil for the first page), the returned next cursor is empty on the last page
+// the order column must be unique (e.g. the primary key) otherwise records sharing a value might be skipped
+// e.g. next, err := utils.PaginateCursor(c.GetGorm(), cursor, 20, "id", &users)
+func PaginateCursor(db *gorm.DB, cursor *Cursor, limit int, orderColumn string, dest interface{}) (string, error) {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Pointer || destValue.Elem().Kind() != reflect.Slice {
+		return "", errors.New("dest must be a pointer to a slice")
+	}
+	if limit <= 0 {
+		return "", errors.New("limit must be greater than zero")
+	}
+	stmt := &gorm.Statement{DB: db}
+	err := stmt.Parse(dest)
+	if err != nil {
+		return "", err
+	}
+	field := stmt.Schema.LookUpField(orderColumn)
+	if field == nil {
+		return "", fmt.Errorf("unknown order column %v", orderColumn)
+	}
+	column := clause.Column{Table: clause.CurrentTable, Name: field.DBName}
+	query := db
+	if cursor != nil {
+		// a cursor signed for another column can't be used with this one
+		if cursor.Column != field.DBName {
+			return "", ErrInvalidCursor
+		}
+		// decode the value into the field type, so e.g. times are compared as times and not as strings
+		after := reflect.New(field.FieldType)
+		err = json.Unmarshal(cursor.After, after.Interface())
+		if err != nil {
+			return "", ErrInvalidCursor
+		}
+		query = query.Where(clause.Gt{Column: column, Value: after.Elem().Interface()})
+	}
+	res := query.Order(clause.OrderByColumn{Column: column}).Limit(limit + 1).Find(dest)
+	if res.Error != nil {
+		return "", res.Error
+	}
+	records := destValue.Elem()
+	if records.Len() <= limit {
+		return "", nil
+	}
+	records.Set(records.Slice(0, limit))
+	last, _ := field.ValueOf(context.Background(), reflect.Indirect(records.Index(limit-1)))
+	after, err := json.Marshal(last)
+	if err != nil {
+		return "", err
+	}
+	return EncodeCursor(Cursor{Column: field.DBName, After: after})
+}
+
+func signCursor(encodedPayload string) (string, error) {
+	secret := env.GetVar("App_CURSOR_SECRET")
+	if secret == "" {
+		secret = env.GetVar("JWT_SECRET")
+	}
+	if secret == "" {
+		return "", ErrMissingCursorSecret
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(encodedPayload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+// The batch size used when the given batch size is not positive
+const DefaultBatchInsertSize = 100
+
+// Returned by the batch insert when one of the batches fails, nothing is inserted when it happens
+type BatchInsertError struct {
+	// The number of the failed batch, starting from 1
+	Batch int
+	// The index of the first record of the failed batch
+	FirstRecord int
+	// The index of the last record of the failed batch
+	LastRecord int
+	Err        error
+}
+
+func (e *BatchInsertError) Error() string {
+	return fmt.Sprintf("batch %v (records %v to %v) failed: %v", e.Batch, e.FirstRecord, e.LastRecord, e.Err)
+}
+
+func (e *BatchInsertError) Unwrap() error {
+	return e.Err
+}
+
+// Insert the records (a slice of models) in batches in a single transaction
+// e.g. err := utils.BatchInsert(c.GetGorm(), users, 500)
+func BatchInsert(db *gorm.DB, records interface{}, batchSize int) error {
+	return BatchInsertWithProgress(db, records, batchSize, nil)
+}
+
+// Same as BatchInsert but calls progress after every inserted batch with the number of inserted records so far
+func BatchInsertWithProgress(db *gorm.DB, records interface{}, batchSize int, progress func(inserted int, total int)) error {
+	recordsValue := reflect.Indirect(reflect.ValueOf(records))
+	if recordsValue.Kind() != reflect.Slice {
+		return errors.New("records must be a slice")
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchInsertSize
+	}
+	total := recordsValue.Len()
+	return db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < total; start = start + batchSize {
+			end := start + batchSize
+			if end > total {
+				end = total
+			}
+			err := tx.Create(recordsValue.Slice(start, end).Interface()).Error
+			if err != nil {
+				return &BatchInsertError{
+					Batch:       start/batchSize + 1,
+					FirstRecord: start,
+					LastRecord:  end - 1,
+					Err:         err,
+				}
+			}
+			if progress != nil {
+				progress(end, total)
+			}
+		}
+		return nil
+	})
+}
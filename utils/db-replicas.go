@@ -0,0 +1,48 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"path"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+// Send the read queries to the given replicas, the write queries and the transactions
+// keep going to the main connection, the replicas use the same driver as the main connection,
+// for sqlite the replicas paths are relative to the base path like SQLITE_DB_PATH
+func UseDBReplicas(db *gorm.DB, driver string, replicasDSNs []string, basePath string) error {
+	if len(replicasDSNs) == 0 {
+		return nil
+	}
+	var replicas []gorm.Dialector
+	for _, dsn := range replicasDSNs {
+		switch driver {
+		case "mysql":
+			replicas = append(replicas, mysql.Open(dsn))
+		case "postgres":
+			replicas = append(replicas, postgres.Open(dsn))
+		case "sqlite":
+			replicas = append(replicas, sqlite.Open(path.Join(basePath, dsn)))
+		default:
+			return fmt.Errorf("database replicas are not supported for the driver %v", driver)
+		}
+	}
+	return db.Use(dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	}))
+}
+
+// Force the queries to go to the main connection, useful for reading right after writing
+// e.g. utils.UsePrimaryDB(c.GetGorm()).First(&user)
+func UsePrimaryDB(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Write)
+}
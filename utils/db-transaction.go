@@ -0,0 +1,50 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// Run the given function in a transaction, if the transaction fails because of a deadlock
+// or a serialization failure the whole function is retried up to the given number of attempts
+// with a growing delay between the attempts, so only use it with functions that are safe to rerun,
+// the function always runs at least once even if attempts is less than 1
+func TransactionWithRetry(db *gorm.DB, attempts int, fn func(tx *gorm.DB) error) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = db.Transaction(fn)
+		if err == nil || !IsDeadlockError(err) {
+			return err
+		}
+		if attempt < attempts {
+			time.Sleep(time.Duration(attempt*attempt) * 10 * time.Millisecond)
+		}
+	}
+	return err
+}
+
+// Check if the error is a deadlock or a serialization failure caused by concurrent transactions
+func IsDeadlockError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// ER_LOCK_DEADLOCK
+		return mysqlErr.Number == 1213
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// serialization_failure, deadlock_detected
+		return pgErr.Code == "40001" || pgErr.Code == "40P01"
+	}
+	return false
+}
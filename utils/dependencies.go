@@ -0,0 +1,73 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+)
+
+// A service the app depends on, Check returns nil when the service is reachable
+type Dependency struct {
+	Name  string
+	Check func() error
+}
+
+// A dependency that is reachable when a tcp connection to the given address (host:port) can be opened
+func TCPDependency(name string, address string) Dependency {
+	return Dependency{
+		Name: name,
+		Check: func() error {
+			conn, err := net.DialTimeout("tcp", address, time.Second*2)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
+
+// A dependency that is reachable when the given url responds with a status code below 500
+func HTTPDependency(name string, url string) Dependency {
+	return Dependency{
+		Name: name,
+		Check: func() error {
+			client := http.Client{Timeout: time.Second * 2}
+			res, err := client.Get(url)
+			if err != nil {
+				return err
+			}
+			res.Body.Close()
+			if res.StatusCode >= 500 {
+				return fmt.Errorf("responded with status code %v", res.StatusCode)
+			}
+			return nil
+		},
+	}
+}
+
+// Wait until all the dependencies are reachable, an error is returned if any of them
+// is still not reachable after the given timeout
+func WaitForDependencies(deps []Dependency, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for _, dep := range deps {
+		for {
+			err := dep.Check()
+			if err == nil {
+				log.Printf("dependency %v is reachable\n", dep.Name)
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timed out waiting for dependency %v: %v", dep.Name, err)
+			}
+			log.Printf("waiting for dependency %v: %v\n", dep.Name, err)
+			time.Sleep(time.Second)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gocondor/core/logger"
+)
+
+// The source of the feature flags, implement it to load the flags from a remote service
+type FeatureFlagsProvider interface {
+	IsEnabled(flagName string) bool
+}
+
+// Feature flags read from the env vars, the flag `new-checkout` is read from the env var FEATURE_NEW_CHECKOUT
+type EnvFeatureFlags struct{}
+
+func (e EnvFeatureFlags) IsEnabled(flagName string) bool {
+	varName := "FEATURE_" + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(flagName))
+	enabled, _ := strconv.ParseBool(os.Getenv(varName))
+	return enabled
+}
+
+// Feature flags read from a json file in the form {"new-checkout": true}
+// the file is checked for changes at most once every CheckInterval (5 seconds by default),
+// so flags can be toggled without restarting the app
+type FileFeatureFlags struct {
+	FilePath      string
+	CheckInterval time.Duration
+	mu            sync.RWMutex
+	lastCheck     time.Time
+	modTime       time.Time
+	flags         map[string]bool
+}
+
+func (f *FileFeatureFlags) IsEnabled(flagName string) bool {
+	interval := f.CheckInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	f.mu.RLock()
+	if time.Since(f.lastCheck) < interval {
+		enabled := f.flags[flagName]
+		f.mu.RUnlock()
+		return enabled
+	}
+	f.mu.RUnlock()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if time.Since(f.lastCheck) < interval {
+		// another request reloaded the file meanwhile
+		return f.flags[flagName]
+	}
+	f.lastCheck = time.Now()
+	fileInfo, err := os.Stat(f.FilePath)
+	if err != nil {
+		logger.ResolveLogger().Error(fmt.Sprintf("error reading feature flags file: %v", err))
+		return f.flags[flagName]
+	}
+	if fileInfo.ModTime() != f.modTime {
+		content, err := os.ReadFile(f.FilePath)
+		if err != nil {
+			logger.ResolveLogger().Error(fmt.Sprintf("error reading feature flags file: %v", err))
+			return f.flags[flagName]
+		}
+		var flags map[string]bool
+		err = json.Unmarshal(content, &flags)
+		if err != nil {
+			logger.ResolveLogger().Error(fmt.Sprintf("error parsing feature flags file: %v", err))
+			return f.flags[flagName]
+		}
+		f.flags = flags
+		f.modTime = fileInfo.ModTime()
+	}
+	return f.flags[flagName]
+}
+
+var featureFlags FeatureFlagsProvider = EnvFeatureFlags{}
+
+// Set the source of the feature flags
+func SetFeatureFlagsProvider(provider FeatureFlagsProvider) {
+	featureFlags = provider
+}
+
+// Check if the given feature flag is enabled
+func IsFeatureEnabled(flagName string) bool {
+	return featureFlags.IsEnabled(flagName)
+}
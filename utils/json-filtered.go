@@ -0,0 +1,102 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gocondor/core"
+)
+
+// Respond with json containing only the fields requested in the query param `fields` (e.g. `?fields=id,name`)
+// the data can be a single object or a list of objects, only the top-level keys are filtered,
+// the requested fields are validated against the allowed fields, when the param `fields` is not
+// set the data is returned in full
+func JSONFiltered(c *core.Context, status int, data interface{}, allowedFields []string) *core.Response {
+	j, err := json.Marshal(data)
+	if err != nil {
+		c.GetLogger().Error(err.Error())
+		return c.Response.SetStatusCode(http.StatusInternalServerError).Json(c.MapToJson(map[string]string{
+			"message": "internal server error",
+		}))
+	}
+	fields := requestedFields(c)
+	if len(fields) == 0 {
+		return c.Response.SetStatusCode(status).Json(string(j))
+	}
+	for _, field := range fields {
+		if !contains(allowedFields, field) {
+			return c.Response.SetStatusCode(http.StatusBadRequest).Json(c.MapToJson(map[string]string{
+				"message": fmt.Sprintf("field %v is not allowed", field),
+			}))
+		}
+	}
+
+	var filtered interface{}
+	var object map[string]interface{}
+	var list []map[string]interface{}
+	if decodeJSON(j, &object) == nil {
+		filtered = filterFields(object, fields)
+	} else if decodeJSON(j, &list) == nil {
+		filteredList := []map[string]interface{}{}
+		for _, item := range list {
+			filteredList = append(filteredList, filterFields(item, fields))
+		}
+		filtered = filteredList
+	} else {
+		// not an object, nothing to filter
+		return c.Response.SetStatusCode(status).Json(string(j))
+	}
+	j, err = json.Marshal(filtered)
+	if err != nil {
+		c.GetLogger().Error(err.Error())
+		return c.Response.SetStatusCode(http.StatusInternalServerError).Json(c.MapToJson(map[string]string{
+			"message": "internal server error",
+		}))
+	}
+	return c.Response.SetStatusCode(status).Json(string(j))
+}
+
+// decode the json keeping the numbers as json.Number, so large ids aren't turned into float64
+func decodeJSON(j []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+func requestedFields(c *core.Context) []string {
+	var fields []string
+	for _, field := range strings.Split(c.CastToString(c.GetRequestParam("fields")), ",") {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func filterFields(object map[string]interface{}, fields []string) map[string]interface{} {
+	filtered := map[string]interface{}{}
+	for _, field := range fields {
+		val, ok := object[field]
+		if ok {
+			filtered[field] = val
+		}
+	}
+	return filtered
+}
+
+func contains(list []string, item string) bool {
+	for _, v := range list {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gocondor/core"
+)
+
+// Set the `Last-Modified` header of the response
+func SetLastModified(c *core.Context, lastModified time.Time) {
+	c.Response.SetHeader("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+}
+
+// Check if the client's copy of the resource is still up to date according to the `If-Modified-Since` header,
+// use it early in GET handlers to skip rendering unchanged resources, e.g.
+//
+//	if utils.IsNotModified(c, post.UpdatedAt) {
+//		return utils.NotModified(c, post.UpdatedAt)
+//	}
+func IsNotModified(c *core.Context, lastModified time.Time) bool {
+	// If-None-Match takes precedence over If-Modified-Since
+	if c.GetHeader("If-None-Match") != "" {
+		return false
+	}
+	since, err := http.ParseTime(c.GetHeader("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	// the header has a precision of seconds
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// Respond with 304 not modified
+func NotModified(c *core.Context, lastModified time.Time) *core.Response {
+	SetLastModified(c, lastModified)
+	return c.Response.SetStatusCode(http.StatusNotModified)
+}
@@ -0,0 +1,18 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/gocondor/core"
+)
+
+// Retrieve the remainder of the path matched by a catch-all route param (e.g. `*filepath`)
+// the router always includes the leading slash in the matched value, this helper strips it,
+// so a request to `/files/` on the route `/files/*filepath` returns an empty string
+func GetCatchAllPathParam(c *core.Context, key string) string {
+	return strings.TrimPrefix(c.CastToString(c.GetPathParam(key)), "/")
+}
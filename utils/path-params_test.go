@@ -0,0 +1,48 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gocondor/core"
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestGetCatchAllPathParam(t *testing.T) {
+	app := core.New()
+	routes := []core.Route{
+		{
+			Method: core.GET,
+			Path:   "/files/*filepath",
+			Handler: func(c *core.Context) *core.Response {
+				return c.Response.Text(GetCatchAllPathParam(c, "filepath"))
+			},
+		},
+	}
+	router := app.RegisterRoutes(routes, httprouter.New())
+
+	cases := map[string]string{
+		"/files/":             "",
+		"/files/a":            "a",
+		"/files/a/b":          "a/b",
+		"/files/a/b/file.txt": "a/b/file.txt",
+	}
+	for path, expected := range cases {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		b, err := io.ReadAll(w.Body)
+		if err != nil {
+			t.Errorf("failed testing get catch-all path param")
+		}
+		if string(b) != expected {
+			t.Errorf("failed testing get catch-all path param for %v, expected %q got %q", path, expected, string(b))
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"github.com/gocondor/core"
+)
+
+// Respond with the given text as `text/plain; charset=utf-8`
+func String(c *core.Context, status int, text string) *core.Response {
+	return Bytes(c, status, "text/plain; charset=utf-8", []byte(text))
+}
+
+// Respond with the given bytes and content type, so the client doesn't have to guess it
+// e.g. return utils.Bytes(c, http.StatusOK, "text/csv", report)
+func Bytes(c *core.Context, status int, contentType string, b []byte) *core.Response {
+	return c.Response.SetStatusCode(status).Text(string(b)).SetContentType(contentType)
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gocondor/core/logger"
+	"github.com/julienschmidt/httprouter"
+)
+
+// Options for controlling the reverse proxy
+type ReverseProxyOptions struct {
+	// The max time to wait for the upstream to respond, zero means no timeout
+	Timeout time.Duration
+	// Headers to set on the request before forwarding it to the upstream
+	SetRequestHeaders map[string]string
+	// Headers to remove from the request before forwarding it to the upstream
+	RemoveRequestHeaders []string
+	// Headers to set on the response before returning it to the client
+	SetResponseHeaders map[string]string
+}
+
+var reverseProxyMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodOptions,
+	http.MethodHead,
+}
+
+// Mount a reverse proxy to the given upstream on the given path for all http methods
+// if the path ends with a catch-all param (e.g. `/api/*path`) only the matched remainder
+// is forwarded to the upstream, otherwise the full request path is forwarded
+func MountReverseProxy(router *httprouter.Router, path string, upstream string, opts ReverseProxyOptions) error {
+	proxy, err := NewReverseProxy(upstream, catchAllParamName(path), opts)
+	if err != nil {
+		return err
+	}
+	for _, method := range reverseProxyMethods {
+		router.Handler(method, path, proxy)
+	}
+	return nil
+}
+
+// Create a reverse proxy handler to the given upstream
+func NewReverseProxy(upstream string, catchAllParam string, opts ReverseProxyOptions) (http.Handler, error) {
+	target, err := url.Parse(upstream)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream url %v: %v", upstream, err)
+	}
+	if target.Scheme == "" || target.Host == "" {
+		return nil, fmt.Errorf("invalid upstream url %v: scheme and host are required", upstream)
+	}
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		if catchAllParam != "" {
+			r.URL.Path = httprouter.ParamsFromContext(r.Context()).ByName(catchAllParam)
+			r.URL.RawPath = ""
+		}
+		director(r)
+		r.Host = target.Host
+		for _, key := range opts.RemoveRequestHeaders {
+			r.Header.Del(key)
+		}
+		for key, val := range opts.SetRequestHeaders {
+			r.Header.Set(key, val)
+		}
+	}
+	proxy.ModifyResponse = func(res *http.Response) error {
+		for key, val := range opts.SetResponseHeaders {
+			res.Header.Set(key, val)
+		}
+		return nil
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		logger.ResolveLogger().Error(fmt.Sprintf("reverse proxy to %v failed: %v", upstream, err))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("{\"message\": \"bad gateway\"}"))
+	}
+	if opts.Timeout > 0 {
+		// keep the defaults (keep-alive, idle connections, http2) and only override the timeouts
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.DialContext = (&net.Dialer{
+			Timeout:   opts.Timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+		transport.TLSHandshakeTimeout = opts.Timeout
+		transport.ResponseHeaderTimeout = opts.Timeout
+		proxy.Transport = transport
+	}
+	return proxy, nil
+}
+
+func catchAllParamName(path string) string {
+	i := strings.LastIndex(path, "/*")
+	if i == -1 {
+		return ""
+	}
+	return path[i+2:]
+}
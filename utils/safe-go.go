@@ -0,0 +1,25 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/gocondor/core/logger"
+)
+
+// Run the given function in a goroutine, if it panics the panic is recovered
+// and logged with the stack trace instead of crashing the whole app
+func SafeGo(fn func()) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ResolveLogger().Error(fmt.Sprintf("recovered from panic in goroutine: %v\n%v", r, string(debug.Stack())))
+			}
+		}()
+		fn()
+	}()
+}
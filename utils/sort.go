@@ -0,0 +1,53 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gocondor/core"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Returned (wrapped) when the sort param has a field that is not allowed, respond with 400 when it's returned
+var ErrInvalidSort = errors.New("invalid sort")
+
+// A sort directive parsed from the sort param
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// Parse the query param `sort` in the form `?sort=-created_at,name` (`-` for descending) into sort directives,
+// the allowed map maps the field names exposed in the api to the database columns
+// e.g. utils.GetSortFields(c, map[string]string{"name": "name", "created_at": "created_at"})
+func GetSortFields(c *core.Context, allowed map[string]string) ([]SortField, error) {
+	var fields []SortField
+	for _, field := range strings.Split(c.CastToString(c.GetRequestParam("sort")), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		desc := strings.HasPrefix(field, "-")
+		name := strings.TrimPrefix(field, "-")
+		column, ok := allowed[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: sorting by %v is not allowed", ErrInvalidSort, name)
+		}
+		fields = append(fields, SortField{Column: column, Desc: desc})
+	}
+	return fields, nil
+}
+
+// Add the sort directives to the query
+func ApplySort(db *gorm.DB, fields []SortField) *gorm.DB {
+	for _, field := range fields {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: field.Column}, Desc: field.Desc})
+	}
+	return db
+}
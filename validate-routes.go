@@ -0,0 +1,32 @@
+// Copyright 2023 Harran Ali <harran.m@gmail.com>. All rights reserved.
+// Use of this source code is governed by MIT-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/gocondor/core"
+)
+
+// Make sure no route or middleware is registered with a nil function,
+// otherwise it would only fail when a request hits it
+func validateRoutes() {
+	for i, mw := range core.ResolveMiddlewares().GetMiddlewares() {
+		if mw == nil {
+			log.Fatalf("global middleware number %v is nil", i+1)
+		}
+	}
+	for _, route := range core.ResolveRouter().GetRoutes() {
+		if route.Handler == nil {
+			log.Fatalf("route [%v %v] is registered with a nil handler", strings.ToUpper(route.Method), route.Path)
+		}
+		for i, mw := range route.Middlewares {
+			if mw == nil {
+				log.Fatalf("route [%v %v] middleware number %v is nil", strings.ToUpper(route.Method), route.Path, i+1)
+			}
+		}
+	}
+}